@@ -12,10 +12,13 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/table"
 	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 )
 
 type GitChange struct {
@@ -40,31 +43,46 @@ type GitStatus struct {
 }
 
 type model struct {
-	state       string // "files", "suggestions", "custom", "edit", "output"
+	state       string // "files", "suggestions", "custom", "edit", "output", "log"
 	changes     []GitChange
 	suggestions []CommitSuggestion
 	gitState    GitStatus
 
-	filesTable       table.Model
-	suggestionsTable table.Model
+	filesTable       scrollTable
+	suggestionsTable scrollTable
+	logTable         scrollTable
 	customInput      textinput.Model
 	editInput        textinput.Model
 
-	width        int
-	height       int
-	statusMsg    string
-	statusExpiry time.Time
+	width         int
+	height        int
+	statusMsg     string
+	statusExpiry  time.Time
+	statusHistory []statusEntry
+	logReturnTo   string // state to restore when the status log is closed
 
 	repoPath         string
 	pushOutput       string
 	lastCommit       string
 	lastStatusUpdate time.Time
+
+	showHelp bool
+	helpView viewport.Model
 }
 
 type statusMsg struct {
 	message string
 }
 
+// statusEntry is a status message kept for the status log view
+type statusEntry struct {
+	time    time.Time
+	message string
+}
+
+// maxStatusHistory bounds how many status messages the log keeps
+const maxStatusHistory = 100
+
 type gitChangesMsg []GitChange
 type commitSuggestionsMsg []CommitSuggestion
 type gitStatusMsg GitStatus
@@ -87,6 +105,18 @@ var (
 			MarginTop(1)
 )
 
+// tabs lists the header tabs in display order along with the state each one selects
+var tabs = []struct {
+	key   string
+	label string
+	state string
+}{
+	{"1", "📁 Files", "files"},
+	{"2", "💡 Suggestions", "suggestions"},
+	{"3", "✏️  Custom", "custom"},
+	{"4", "📤 Output", "output"},
+}
+
 func main() {
 	repoPath, err := findGitRepo()
 	if err != nil {
@@ -153,20 +183,38 @@ func main() {
 
 	suggestionsTable.SetStyles(filesStyle) // Use same style
 
-	m.filesTable = filesTable
-	m.suggestionsTable = suggestionsTable
+	// Initialize status log table
+	logColumns := []table.Column{
+		{Title: "Time", Width: 10},
+		{Title: "Message", Width: 70},
+	}
+
+	logTable := table.New(
+		table.WithColumns(logColumns),
+		table.WithFocused(true),
+		table.WithHeight(10),
+	)
+
+	logTable.SetStyles(filesStyle) // Use same style
+
+	m.filesTable = newScrollTable(filesTable)
+	m.suggestionsTable = newScrollTable(suggestionsTable)
+	m.logTable = newScrollTable(logTable)
 
 	// Initialize custom input
 	m.customInput = textinput.New()
 	m.customInput.Placeholder = "Enter your custom commit message..."
 	m.customInput.CharLimit = 200
 
+	// Initialize help overlay; sized when shown
+	m.helpView = viewport.New(0, 0)
+
 	// Initialize edit input
 	m.editInput = textinput.New()
 	m.editInput.Placeholder = "Edit commit message..."
 	m.editInput.CharLimit = 200
 
-	p := tea.NewProgram(m, tea.WithAltScreen())
+	p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithMouseCellMotion())
 	if _, err := p.Run(); err != nil {
 		log.Fatal(err)
 	}
@@ -263,8 +311,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case statusMsg:
-		m.statusMsg = msg.message
-		m.statusExpiry = time.Now().Add(3 * time.Second)
+		m.setStatus(msg.message, 3*time.Second)
 		return m, nil
 
 	case gitChangesMsg:
@@ -282,8 +329,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.lastStatusUpdate = time.Now()
 		}
 
-		m.statusMsg = fmt.Sprintf("✅ Loaded %d changed files", len(m.changes))
-		m.statusExpiry = time.Now().Add(3 * time.Second)
+		m.setStatus(fmt.Sprintf("✅ Loaded %d changed files", len(m.changes)), 3*time.Second)
 
 		return m, tea.Batch(cmds...)
 
@@ -295,8 +341,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.pushOutput = msg.output
 		m.lastCommit = msg.commit
 		m.state = "output"
-		m.statusMsg = "✅ Push completed - check tab 4 for details"
-		m.statusExpiry = time.Now().Add(5 * time.Second)
+		m.setStatus("✅ Push completed - check tab 4 for details", 5*time.Second)
 		return m, nil
 
 	case commitSuggestionsMsg:
@@ -305,8 +350,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Update suggestions table
 		m.updateSuggestionsTable()
 
-		m.statusMsg = fmt.Sprintf("🤖 Generated %d commit suggestions", len(m.suggestions))
-		m.statusExpiry = time.Now().Add(3 * time.Second)
+		m.setStatus(fmt.Sprintf("🤖 Generated %d commit suggestions", len(m.suggestions)), 3*time.Second)
 
 		return m, nil
 
@@ -317,11 +361,61 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		tableHeight := m.height - 8
 		m.filesTable.SetHeight(tableHeight)
 		m.suggestionsTable.SetHeight(tableHeight)
+		m.logTable.SetHeight(tableHeight)
 		m.adjustTableLayout()
+		m.updateHelpView()
 
 		return m, nil
 
+	case tea.MouseMsg:
+		if m.showHelp {
+			// Only the wheel does anything while help is open
+			m.helpView, cmd = m.helpView.Update(msg)
+			return m, cmd
+		}
+		if msg.Action != tea.MouseActionPress {
+			return m, nil
+		}
+
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			if t := m.activeTable(); t != nil {
+				t.MoveUp(1)
+			}
+		case tea.MouseButtonWheelDown:
+			if t := m.activeTable(); t != nil {
+				t.MoveDown(1)
+			}
+		case tea.MouseButtonLeft:
+			if msg.Y == 0 {
+				// Like the keyboard, text input is only left with esc or enter
+				if m.state == "custom" || m.state == "edit" {
+					return m, nil
+				}
+				if state := m.tabAt(msg.X); state != "" {
+					m.switchTab(state)
+				}
+			} else {
+				m.selectRowAt(msg.Y)
+			}
+		}
+		return m, nil
+
 	case tea.KeyMsg:
+		// Help overlay swallows keys until it is dismissed
+		if m.showHelp {
+			switch msg.String() {
+			case "?", "esc":
+				m.showHelp = false
+			case "q", "ctrl+c":
+				return m, tea.Quit
+			default:
+				m.helpView, cmd = m.helpView.Update(msg)
+				return m, cmd
+			}
+			return m, nil
+		}
+
 		// Handle escape first for all states
 		if msg.String() == "esc" {
 			if m.state == "custom" {
@@ -332,6 +426,8 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.editInput.Blur()
 				m.editInput.SetValue("")
 				m.state = "suggestions"
+			} else if m.state == "log" {
+				m.closeLog()
 			}
 			return m, nil
 		}
@@ -399,18 +495,15 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, tea.Quit
 
 			case "1":
-				m.state = "files"
+				m.switchTab("files")
 				return m, nil
 
 			case "2":
-				if len(m.suggestions) > 0 {
-					m.state = "suggestions"
-				}
+				m.switchTab("suggestions")
 				return m, nil
 
 			case "3":
-				m.state = "custom"
-				m.customInput.Focus()
+				m.switchTab("custom")
 				return m, nil
 
 			case "e":
@@ -466,7 +559,16 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				)
 
 			case "4":
-				m.state = "output"
+				m.switchTab("output")
+				return m, nil
+
+			case "l":
+				if m.state == "log" {
+					m.closeLog()
+				} else {
+					m.logReturnTo = m.state
+					m.switchTab("log")
+				}
 				return m, nil
 
 			case "h":
@@ -479,10 +581,10 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, m.checkHookStatus()
 
 			case "?":
-				// Show valid commit message format status
-				return m, func() tea.Msg {
-					return statusMsg{message: "Valid formats: feat(scope): description | fix: description | docs/test/chore: description"}
-				}
+				m.showHelp = true
+				m.updateHelpView()
+				m.helpView.GotoTop()
+				return m, nil
 			}
 		}
 	}
@@ -497,6 +599,8 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.customInput, cmd = m.customInput.Update(msg)
 	case "edit":
 		m.editInput, cmd = m.editInput.Update(msg)
+	case "log":
+		m.logTable, cmd = m.logTable.Update(msg)
 	case "output":
 		// Output view doesn't need input handling
 		break
@@ -508,47 +612,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (m model) View() string {
 	var content string
 
-	// Check hook status for display
-	hookPath := filepath.Join(m.repoPath, ".git", "hooks", "commit-msg")
-	hookStatus := ""
-	if _, err := os.Stat(hookPath); err == nil {
-		hookStatus = " 🔒"
-	}
-
-	// Create all header components
-	title := titleStyle.Render("🚀 Git Commit Helper")
-	repoInfo := repositoryStyle.Render(fmt.Sprintf(" Repository: %s%s", filepath.Base(m.repoPath), hookStatus))
-
-	// Create git status bar
-	gitStatusBar := m.renderGitStatusBar()
-
-	// Create tabs
-	tab1 := m.renderTab("1", "📁 Files", m.state == "files")
-	tab2 := m.renderTab("2", "💡 Suggestions", m.state == "suggestions")
-	tab3 := m.renderTab("3", "✏️  Custom", m.state == "custom")
-	tab4 := m.renderTab("4", "📤 Output", m.state == "output")
-
-	// Calculate spacing to keep everything on one line
-	spacer := strings.Repeat(" ", 2)
-
-	// Combine everything on one line
-	fullHeader := lipgloss.JoinHorizontal(
-		lipgloss.Top,
-		title,
-		repoInfo,
-		spacer,
-		tab1,
-		tab2,
-		tab3,
-		tab4,
-	)
-
-	// Combine header with git status
-	header := lipgloss.JoinVertical(
-		lipgloss.Left,
-		fullHeader,
-		gitStatusBar,
-	)
+	header := m.renderHeader()
 
 	// Content based on current state
 	switch m.state {
@@ -600,12 +664,21 @@ func (m model) View() string {
 				Foreground(lipgloss.Color("240")).
 				Render("No push output available. Use 'p' to push changes.")
 		}
+
+	case "log":
+		if len(m.statusHistory) == 0 {
+			content = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("240")).
+				Render("No status messages yet.")
+		} else {
+			content = m.logTable.View()
+		}
 	}
 
 	// Footer with help and status
 	footer := m.renderFooter()
 
-	return lipgloss.JoinVertical(
+	view := lipgloss.JoinVertical(
 		lipgloss.Left,
 		header,
 		"",
@@ -613,6 +686,127 @@ func (m model) View() string {
 		"",
 		helpStyle.Render(footer),
 	)
+
+	if m.showHelp {
+		return overlayCenter(view, m.renderHelp(), m.width, m.height)
+	}
+	return view
+}
+
+func (m model) renderHeader() string {
+	// Combine title and tabs on one line
+	parts := []string{m.renderTitleBar()}
+	for _, tab := range tabs {
+		parts = append(parts, m.renderTab(tab.key, tab.label, m.state == tab.state))
+	}
+	fullHeader := lipgloss.JoinHorizontal(lipgloss.Top, parts...)
+
+	// Combine header with git status
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		fullHeader,
+		m.renderGitStatusBar(),
+	)
+}
+
+// renderTitleBar renders the title and repository info that precede the tabs
+func (m model) renderTitleBar() string {
+	// Check hook status for display
+	hookPath := filepath.Join(m.repoPath, ".git", "hooks", "commit-msg")
+	hookStatus := ""
+	if _, err := os.Stat(hookPath); err == nil {
+		hookStatus = " 🔒"
+	}
+
+	title := titleStyle.Render("🚀 Git Commit Helper")
+	repoInfo := repositoryStyle.Render(fmt.Sprintf(" Repository: %s%s", filepath.Base(m.repoPath), hookStatus))
+
+	// Calculate spacing to keep everything on one line
+	spacer := strings.Repeat(" ", 2)
+
+	return title + repoInfo + spacer
+}
+
+// tabAt returns the state of the header tab at column x, or "" when x is not over a tab
+func (m model) tabAt(x int) string {
+	offset := lipgloss.Width(m.renderTitleBar())
+	for _, tab := range tabs {
+		width := lipgloss.Width(m.renderTab(tab.key, tab.label, m.state == tab.state))
+		if x >= offset && x < offset+width {
+			return tab.state
+		}
+		offset += width
+	}
+	return ""
+}
+
+// switchTab changes to the given state, leaving any text input first
+func (m *model) switchTab(state string) {
+	if state == m.state || (state == "suggestions" && len(m.suggestions) == 0) {
+		return
+	}
+
+	m.customInput.Blur()
+	m.editInput.Blur()
+	m.state = state
+	if state == "custom" {
+		m.customInput.Focus()
+	}
+	if state == "log" {
+		// Open on the newest message
+		m.logTable.GotoBottom()
+	}
+}
+
+// closeLog leaves the status log for the view it was opened from
+func (m *model) closeLog() {
+	if m.logReturnTo != "" {
+		m.switchTab(m.logReturnTo)
+	}
+	if m.state == "log" {
+		// Previous view is gone (e.g. suggestions were cleared)
+		m.switchTab("files")
+	}
+}
+
+// activeTable returns the table shown for the current state, if any
+func (m *model) activeTable() *scrollTable {
+	switch m.state {
+	case "files":
+		return &m.filesTable
+	case "suggestions":
+		return &m.suggestionsTable
+	case "log":
+		return &m.logTable
+	}
+	return nil
+}
+
+// selectRowAt moves the cursor of the active table to the row rendered at screen line y
+func (m *model) selectRowAt(y int) {
+	t := m.activeTable()
+	if t == nil {
+		return
+	}
+
+	// Header, blank line, then the table's own column headers
+	rowsTop := lipgloss.Height(m.renderHeader()) + 1 + lipgloss.Height(t.View()) - t.Height()
+	line := y - rowsTop
+	if line < 0 || line >= t.Height() {
+		return
+	}
+
+	row := t.firstVisibleRow() + line
+	if row >= t.end {
+		return
+	}
+
+	// Move rather than jump so the table scrolls the same way it does for keys
+	if row > t.Cursor() {
+		t.MoveDown(row - t.Cursor())
+	} else if row < t.Cursor() {
+		t.MoveUp(t.Cursor() - row)
+	}
 }
 
 func (m model) renderTab(key, label string, active bool) string {
@@ -648,7 +842,7 @@ func (m model) renderFooter() string {
 			keyStyle.Render("A"), actionStyle.Render("amend"),
 			keyStyle.Render("s"), actionStyle.Render("status"), bulletStyle.Render("•"),
 			keyStyle.Render("h/H"), actionStyle.Render("hooks"), bulletStyle.Render("•"),
-			keyStyle.Render("i/?"), actionStyle.Render("info"), bulletStyle.Render("•"),
+			keyStyle.Render("i/?"), actionStyle.Render("info/help"), bulletStyle.Render("•"),
 			keyStyle.Render("q"), actionStyle.Render("quit"))
 	case "suggestions":
 		footer = fmt.Sprintf("%s: %s %s %s: %s %s %s: %s %s %s: %s %s %s: %s %s %s: %s\n%s: %s %s %s: %s %s %s: %s",
@@ -659,7 +853,7 @@ func (m model) renderFooter() string {
 			keyStyle.Render("a/R/A"), actionStyle.Render("add/reset/amend"), bulletStyle.Render("•"),
 			keyStyle.Render("p"), actionStyle.Render("push"),
 			keyStyle.Render("h/H"), actionStyle.Render("hooks"), bulletStyle.Render("•"),
-			keyStyle.Render("i/?"), actionStyle.Render("info"), bulletStyle.Render("•"),
+			keyStyle.Render("i/?"), actionStyle.Render("info/help"), bulletStyle.Render("•"),
 			keyStyle.Render("q"), actionStyle.Render("quit"))
 	case "custom":
 		footer = fmt.Sprintf("%s: %s %s %s: %s",
@@ -673,6 +867,18 @@ func (m model) renderFooter() string {
 		footer = fmt.Sprintf("%s: %s %s %s: %s",
 			keyStyle.Render("1-4"), actionStyle.Render("switch tabs"), bulletStyle.Render("•"),
 			keyStyle.Render("q"), actionStyle.Render("quit"))
+	case "log":
+		footer = fmt.Sprintf("%s: %s %s %s: %s %s %s: %s %s %s: %s",
+			keyStyle.Render("1-4"), actionStyle.Render("switch tabs"), bulletStyle.Render("•"),
+			keyStyle.Render("↑↓"), actionStyle.Render("scroll"), bulletStyle.Render("•"),
+			keyStyle.Render("l/esc"), actionStyle.Render("close log"), bulletStyle.Render("•"),
+			keyStyle.Render("q"), actionStyle.Render("quit"))
+	}
+
+	// Add row position for table views
+	if t := m.activeTable(); t != nil && len(t.Rows()) > 0 {
+		footer = fmt.Sprintf("%s %s %s", strings.TrimRight(footer, " "), bulletStyle.Render("•"),
+			actionStyle.Render(fmt.Sprintf("row %d/%d", t.Cursor()+1, len(t.Rows()))))
 	}
 
 	// Add status message if present
@@ -691,6 +897,198 @@ func (m model) renderFooter() string {
 	return footer
 }
 
+// helpSections lists the shortcuts shown in the help overlay, grouped by mode
+var helpSections = []struct {
+	title string
+	keys  [][2]string
+}{
+	{"Navigation", [][2]string{
+		{"1-4", "switch tabs"},
+		{"↑↓ / j k", "move up/down"},
+		{"pgup/pgdn", "move a full page"},
+		{"home/end", "jump to first/last row"},
+		{"mouse", "click tab/row, scroll"},
+		{"shift+drag", "select text to copy"},
+		{"?", "toggle this help"},
+		{"q", "quit"},
+	}},
+	{"Files", [][2]string{
+		{"a", "stage all files"},
+		{"R", "unstage all files"},
+		{"A", "amend last commit"},
+		{"r", "refresh changes"},
+		{"s", "show git status"},
+		{"l", "show status log"},
+		{"p", "push to remote"},
+	}},
+	{"Suggestions", [][2]string{
+		{"enter", "commit suggestion"},
+		{"e", "edit suggestion"},
+	}},
+	{"Custom / Edit", [][2]string{
+		{"enter", "commit message"},
+		{"esc", "cancel"},
+	}},
+	{"Hooks", [][2]string{
+		{"h", "install hook"},
+		{"H", "remove hook"},
+		{"i", "show hook status"},
+	}},
+}
+
+// helpContent lays out the help sections in as many columns as fit in width
+func helpContent(width int) string {
+	sectionStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("208"))
+	keyWidth := 12
+	gap := "   "
+
+	longest := 0
+	for _, section := range helpSections {
+		for _, k := range section.keys {
+			longest = max(longest, lipgloss.Width(k[1]))
+		}
+	}
+
+	// Use the most columns that still leave room for readable descriptions
+	cols := 1
+	for n := len(helpSections); n > 1; n-- {
+		if (width-len(gap)*(n-1))/n >= 2+keyWidth+20 {
+			cols = n
+			break
+		}
+	}
+	colWidth := min((width-len(gap)*(cols-1))/cols, 2+keyWidth+longest)
+	descWidth := max(colWidth-2-keyWidth, 8)
+
+	keyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("39")).Width(keyWidth)
+	actionStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("86")).Width(descWidth)
+
+	blocks := make([]string, len(helpSections))
+	heights := make([]int, len(helpSections))
+	for i, section := range helpSections {
+		lines := []string{sectionStyle.Render(section.title)}
+		for _, k := range section.keys {
+			lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top, "  ", keyStyle.Render(k[0]), actionStyle.Render(k[1])))
+		}
+		blocks[i] = strings.Join(lines, "\n")
+		heights[i] = lipgloss.Height(blocks[i]) + 1
+	}
+
+	var columns []string
+	_, sizes := balanceColumns(heights, cols)
+	for _, size := range sizes {
+		if len(columns) > 0 {
+			columns = append(columns, gap)
+		}
+		columns = append(columns, strings.Join(blocks[:size], "\n\n"))
+		blocks = blocks[size:]
+	}
+	body := lipgloss.JoinHorizontal(lipgloss.Top, columns...)
+
+	formats := "Valid formats: feat(scope): description | fix: description | docs/test/chore: description"
+	formatsLine := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Width(min(width, max(lipgloss.Width(body), lipgloss.Width(formats)))).
+		Render(formats)
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		titleStyle.Render("⌨️  Keyboard Shortcuts"),
+		"",
+		body,
+		"",
+		formatsLine,
+	)
+}
+
+// balanceColumns splits consecutive blocks into at most cols groups so the
+// tallest group is as short as possible, returning that height and the group sizes
+func balanceColumns(heights []int, cols int) (int, []int) {
+	total := 0
+	for _, h := range heights {
+		total += h
+	}
+	tallest, sizes := total, []int{len(heights)}
+	if cols <= 1 {
+		return tallest, sizes
+	}
+
+	run := 0
+	for i := 1; i < len(heights); i++ {
+		run += heights[i-1]
+		rest, restSizes := balanceColumns(heights[i:], cols-1)
+		if t := max(run, rest); t < tallest {
+			tallest, sizes = t, append([]int{i}, restSizes...)
+		}
+	}
+	return tallest, sizes
+}
+
+// updateHelpView sizes the help viewport to the terminal, scrolling when it doesn't fit
+func (m *model) updateHelpView() {
+	// Border and horizontal padding take two columns each side
+	content := helpContent(max(m.width-4, 1))
+
+	m.helpView.Width = lipgloss.Width(content)
+	// Leave room for the border and the close hint
+	m.helpView.Height = max(min(lipgloss.Height(content), m.height-3), 1)
+	m.helpView.SetContent(content)
+}
+
+func (m model) renderHelp() string {
+	hint := "?/esc: close help"
+	if !m.helpView.AtTop() || !m.helpView.AtBottom() {
+		hint = "↑↓: scroll • " + hint
+	}
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		Padding(0, 1).
+		Render(lipgloss.JoinVertical(lipgloss.Left,
+			m.helpView.View(),
+			helpStyle.UnsetMarginTop().MaxWidth(m.helpView.Width).Render(hint)))
+
+	return box
+}
+
+// overlayCenter draws box over the middle of base, leaving the rest of base visible
+func overlayCenter(base, box string, width, height int) string {
+	baseLines := strings.Split(base, "\n")
+	for len(baseLines) < height {
+		baseLines = append(baseLines, "")
+	}
+	if height > 0 && len(baseLines) > height {
+		baseLines = baseLines[:height]
+	}
+
+	boxLines := strings.Split(box, "\n")
+	boxWidth := lipgloss.Width(box)
+	top := max((len(baseLines)-len(boxLines))/2, 0)
+	left := max((width-boxWidth)/2, 0)
+
+	for i, boxLine := range boxLines {
+		y := top + i
+		if y >= len(baseLines) {
+			break
+		}
+		line := baseLines[y]
+		if w := ansi.StringWidth(line); w < left {
+			line += strings.Repeat(" ", left-w)
+		}
+		// Reset around the box so styles from the base line don't bleed into it
+		baseLines[y] = ansi.Truncate(line, left, "") + ansi.ResetStyle + boxLine + ansi.ResetStyle + ansi.TruncateLeft(line, left+boxWidth, "")
+	}
+
+	// Keep lines within the terminal so none of them wrap
+	if width > 0 {
+		for i, line := range baseLines {
+			baseLines[i] = ansi.Truncate(line, width, "")
+		}
+	}
+
+	return strings.Join(baseLines, "\n")
+}
+
 func (m model) renderGitStatusBar() string {
 	// Status indicators
 	cleanIcon := "✅"
@@ -1847,6 +2245,38 @@ func (m *model) updateFilesTable() {
 	m.filesTable.SetRows(rows)
 }
 
+// setStatus shows a status message for the given duration and records it in the status log
+func (m *model) setStatus(message string, d time.Duration) {
+	now := time.Now()
+	m.statusMsg = message
+	m.statusExpiry = now.Add(d)
+
+	m.statusHistory = append(m.statusHistory, statusEntry{time: now, message: message})
+	dropped := len(m.statusHistory) - maxStatusHistory
+	if dropped > 0 {
+		m.statusHistory = m.statusHistory[dropped:]
+	}
+	m.updateLogTable()
+
+	// Keep the cursor on the same entry when the oldest ones are dropped
+	if dropped > 0 {
+		m.logTable.MoveUp(dropped)
+	}
+}
+
+func (m *model) updateLogTable() {
+	// Oldest messages first so new ones don't shift the selected row
+	var rows []table.Row
+	for _, entry := range m.statusHistory {
+		row := table.Row{
+			entry.time.Format("15:04:05"),
+			entry.message,
+		}
+		rows = append(rows, row)
+	}
+	m.logTable.SetRows(rows)
+}
+
 func (m *model) updateSuggestionsTable() {
 	var rows []table.Row
 	for _, suggestion := range m.suggestions {
@@ -1877,4 +2307,179 @@ func (m *model) adjustTableLayout() {
 		{Title: "Message", Width: availableWidth - 15},
 	}
 	m.suggestionsTable.SetColumns(suggestionsColumns)
+
+	// Adjust status log table columns
+	logColumns := []table.Column{
+		{Title: "Time", Width: 10},
+		{Title: "Message", Width: availableWidth - 13},
+	}
+	m.logTable.SetColumns(logColumns)
+}
+
+// scrollTable wraps table.Model and mirrors the scroll position the table keeps
+// internally, so screen lines can be mapped back to rows. The table is not
+// embedded so every cursor or content change goes through the methods below
+// and keeps the mirror in sync. main_test.go checks the mirror against View.
+type scrollTable struct {
+	model table.Model
+
+	start   int // first rendered row
+	end     int // one past the last rendered row
+	yOffset int // viewport offset into the rendered rows
+}
+
+// newScrollTable wraps a configured table; set styles and columns before wrapping
+func newScrollTable(t table.Model) scrollTable {
+	st := scrollTable{model: t}
+	st.syncViewport()
+	return st
+}
+
+func (t scrollTable) View() string {
+	return t.model.View()
+}
+
+func (t scrollTable) Rows() []table.Row {
+	return t.model.Rows()
+}
+
+func (t scrollTable) SelectedRow() table.Row {
+	return t.model.SelectedRow()
+}
+
+func (t scrollTable) Cursor() int {
+	return t.model.Cursor()
+}
+
+func (t scrollTable) Height() int {
+	return t.model.Height()
+}
+
+// firstVisibleRow returns the index of the row shown on the table's first line
+func (t scrollTable) firstVisibleRow() int {
+	return t.start + t.yOffset
+}
+
+func (t scrollTable) Update(msg tea.Msg) (scrollTable, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !t.model.Focused() {
+		return t, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, t.model.KeyMap.LineUp):
+		t.MoveUp(1)
+	case key.Matches(keyMsg, t.model.KeyMap.LineDown):
+		t.MoveDown(1)
+	case key.Matches(keyMsg, t.model.KeyMap.PageUp):
+		t.MoveUp(t.Height())
+	case key.Matches(keyMsg, t.model.KeyMap.PageDown):
+		t.MoveDown(t.Height())
+	case key.Matches(keyMsg, t.model.KeyMap.HalfPageUp):
+		t.MoveUp(t.Height() / 2)
+	case key.Matches(keyMsg, t.model.KeyMap.HalfPageDown):
+		t.MoveDown(t.Height() / 2)
+	case key.Matches(keyMsg, t.model.KeyMap.GotoTop):
+		t.GotoTop()
+	case key.Matches(keyMsg, t.model.KeyMap.GotoBottom):
+		t.GotoBottom()
+	}
+
+	return t, nil
+}
+
+func (t *scrollTable) MoveUp(n int) {
+	t.model.MoveUp(n)
+
+	// Same adjustments table.Model.MoveUp makes before re-rendering
+	cursor, height := t.Cursor(), t.Height()
+	switch {
+	case t.start == 0:
+		t.setYOffset(clamp(t.yOffset, 0, cursor))
+	case t.start < height:
+		t.yOffset = clamp(clamp(t.yOffset+n, 0, cursor), 0, height)
+	case t.yOffset >= 1:
+		t.yOffset = clamp(t.yOffset+n, 1, height)
+	}
+	t.syncViewport()
+}
+
+func (t *scrollTable) MoveDown(n int) {
+	t.model.MoveDown(n)
+	t.syncViewport()
+
+	// Same adjustments table.Model.MoveDown makes after re-rendering
+	cursor, height := t.Cursor(), t.Height()
+	switch {
+	case t.end == len(t.Rows()) && t.yOffset > 0:
+		t.setYOffset(clamp(t.yOffset-n, 1, height))
+	case cursor > (t.end-t.start)/2 && t.yOffset > 0:
+		t.setYOffset(clamp(t.yOffset-n, 1, cursor))
+	case t.yOffset > 1:
+	case cursor > t.yOffset+height-1:
+		t.setYOffset(clamp(t.yOffset+1, 0, 1))
+	}
+}
+
+func (t *scrollTable) GotoTop() {
+	t.MoveUp(t.Cursor())
+}
+
+func (t *scrollTable) GotoBottom() {
+	t.MoveDown(len(t.Rows()))
+}
+
+func (t *scrollTable) SetCursor(n int) {
+	t.model.SetCursor(n)
+	t.syncViewport()
+}
+
+// SetRows replaces the rows and pulls the cursor back inside them, since bubbles
+// leaves it at -1 after moving up on an empty table and past the end when rows shrink
+func (t *scrollTable) SetRows(rows []table.Row) {
+	t.model.SetRows(rows)
+	t.syncViewport()
+	t.SetCursor(t.Cursor())
+}
+
+func (t *scrollTable) SetColumns(columns []table.Column) {
+	t.model.SetColumns(columns)
+	t.syncViewport()
+}
+
+func (t *scrollTable) SetHeight(h int) {
+	t.model.SetHeight(h)
+	t.syncViewport()
+}
+
+// syncViewport mirrors table.Model.UpdateViewport and the viewport's SetContent
+func (t *scrollTable) syncViewport() {
+	cursor, height := t.Cursor(), t.Height()
+	if cursor >= 0 {
+		t.start = clamp(cursor-height, 0, cursor)
+	} else {
+		t.start = 0
+	}
+	t.end = clamp(cursor+height, cursor, len(t.Rows()))
+
+	if t.yOffset > t.renderedLines()-1 {
+		t.yOffset = t.maxYOffset()
+	}
+}
+
+// renderedLines is the number of lines in the viewport content; no rows still renders one empty line
+func (t scrollTable) renderedLines() int {
+	return max(t.end-t.start, 1)
+}
+
+func (t scrollTable) maxYOffset() int {
+	return max(0, t.renderedLines()-t.Height())
+}
+
+func (t *scrollTable) setYOffset(n int) {
+	t.yOffset = clamp(n, 0, t.maxYOffset())
+}
+
+func clamp(v, low, high int) int {
+	return min(max(v, low), high)
 }
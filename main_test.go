@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// shownFirstRow returns the index of the first row rendered by the table, or -1 if none is shown
+func shownFirstRow(t scrollTable) int {
+	lines := strings.Split(t.View(), "\n")
+	headerLines := len(lines) - t.Height()
+	if headerLines < 0 || headerLines >= len(lines) {
+		return -1
+	}
+
+	var row int
+	if _, err := fmt.Sscanf(strings.TrimSpace(lines[headerLines]), "row%d", &row); err != nil {
+		return -1
+	}
+	return row
+}
+
+func testRows(n int) []table.Row {
+	rows := make([]table.Row, n)
+	for i := range rows {
+		rows[i] = table.Row{fmt.Sprintf("row%d", i)}
+	}
+	return rows
+}
+
+// TestScrollTableMirrorsView guards the copy of the bubbles scroll logic in scrollTable
+func TestScrollTableMirrorsView(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	keys := []tea.KeyMsg{
+		{Type: tea.KeyUp},
+		{Type: tea.KeyDown},
+		{Type: tea.KeyPgUp},
+		{Type: tea.KeyPgDown},
+		{Type: tea.KeyHome},
+		{Type: tea.KeyEnd},
+		{Type: tea.KeyCtrlU},
+		{Type: tea.KeyCtrlD},
+	}
+
+	for trial := 0; trial < 200; trial++ {
+		st := newScrollTable(table.New(
+			table.WithColumns([]table.Column{{Title: "Row", Width: 10}}),
+			table.WithFocused(true),
+			table.WithHeight(2+rng.Intn(12)),
+		))
+		st.SetRows(testRows(rng.Intn(60)))
+
+		for op := 0; op < 200; op++ {
+			var action string
+			switch n := rng.Intn(20); {
+			case n < 12:
+				key := keys[rng.Intn(len(keys))]
+				action = "key " + key.String()
+				st, _ = st.Update(key)
+			case n < 14:
+				rows := rng.Intn(3)
+				action = fmt.Sprintf("MoveUp(%d)", rows)
+				st.MoveUp(rows)
+			case n < 16:
+				rows := rng.Intn(3)
+				action = fmt.Sprintf("MoveDown(%d)", rows)
+				st.MoveDown(rows)
+			case n < 17:
+				cursor := rng.Intn(70)
+				action = fmt.Sprintf("SetCursor(%d)", cursor)
+				st.SetCursor(cursor)
+			case n < 19:
+				rows := rng.Intn(60)
+				action = fmt.Sprintf("SetRows(%d)", rows)
+				st.SetRows(testRows(rows))
+			default:
+				height := 2 + rng.Intn(12)
+				action = fmt.Sprintf("SetHeight(%d)", height)
+				st.SetHeight(height)
+			}
+
+			// An empty table renders no rows; otherwise SetRows keeps the cursor inside them
+			if len(st.Rows()) == 0 {
+				continue
+			}
+			if st.Cursor() < 0 || st.Cursor() >= len(st.Rows()) {
+				t.Fatalf("trial %d op %d after %s: cursor %d outside %d rows", trial, op, action, st.Cursor(), len(st.Rows()))
+			}
+
+			if got, want := st.firstVisibleRow(), shownFirstRow(st); got != want {
+				t.Fatalf("trial %d op %d after %s: firstVisibleRow() = %d, View shows row %d first (cursor %d, height %d, rows %d)",
+					trial, op, action, got, want, st.Cursor(), st.Height(), len(st.Rows()))
+			}
+		}
+	}
+}